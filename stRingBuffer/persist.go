@@ -0,0 +1,206 @@
+package stRingBuffer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Identifies the binary format written by MarshalBinary, and its version.
+const magic = "SRB1"
+
+/*
+  MarshalBinary implements encoding.BinaryMarshaler. It encodes the magic
+  header, the capacity, length, start and end of a StRingBuffer, followed
+  by its underlying line slice, each line prefixed with its byte length.
+*/
+func (s *StRingBuffer) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	for _, field := range []int{len(s.lines), s.length, s.start, s.end} {
+		if err := binary.Write(&buf, binary.BigEndian, uint32(field)); err != nil {
+			return nil, err
+		}
+	}
+	for _, line := range s.lines {
+		b := []byte(line)
+		if err := binary.Write(&buf, binary.BigEndian, uint32(len(b))); err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+/*
+  UnmarshalBinary implements encoding.BinaryUnmarshaler, restoring a
+  StRingBuffer from data produced by MarshalBinary.
+*/
+func (s *StRingBuffer) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+
+	m := make([]byte, len(magic))
+	if _, err := io.ReadFull(r, m); err != nil {
+		return err
+	}
+	if string(m) != magic {
+		return fmt.Errorf("stRingBuffer: unrecognized magic/version %q", m)
+	}
+
+	var capacity, length, start, end uint32
+	for _, p := range []*uint32{&capacity, &length, &start, &end} {
+		if err := binary.Read(r, binary.BigEndian, p); err != nil {
+			return err
+		}
+	}
+
+	lines := make([]string, capacity)
+	for i := range lines {
+		var n uint32
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return err
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+		lines[i] = string(b)
+	}
+
+	s.lines = lines
+	s.length = int(length)
+	s.start = int(start)
+	s.end = int(end)
+	return nil
+}
+
+// SaveFile writes a StRingBuffer's MarshalBinary encoding to path.
+func (s *StRingBuffer) SaveFile(path string) error {
+	data, err := s.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadFile reads a StRingBuffer back from a file written by SaveFile.
+func LoadFile(path string) (*StRingBuffer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := NewStRingBuffer(0)
+	if err := s.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+/*
+  Appender is a length-delimited, append-only log file: every line is
+  stored as a varint byte length followed by its UTF-8 bytes. Once the
+  file grows past capacity*avgLineSize bytes, it is rotated down to its last
+  capacity lines, so crash recovery never replays more than a bounded
+  amount of history.
+*/
+type Appender struct {
+	file        *os.File
+	capacity    int
+	avgLineSize int
+}
+
+// NewAppender opens (creating if necessary) path as an Appender.
+func NewAppender(path string, capacity, avgLineSize int) (*Appender, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &Appender{file: f, capacity: capacity, avgLineSize: avgLineSize}, nil
+}
+
+// Close closes the underlying file.
+func (a *Appender) Close() error {
+	return a.file.Close()
+}
+
+// Append writes line to the log, rotating the file if it has grown too large.
+func (a *Appender) Append(line string) error {
+	if err := a.writeEntry(line); err != nil {
+		return err
+	}
+	info, err := a.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() > int64(a.capacity*a.avgLineSize) {
+		return a.rotate()
+	}
+	return nil
+}
+
+func (a *Appender) writeEntry(line string) error {
+	header := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(header, uint64(len(line)))
+	if _, err := a.file.Write(header[:n]); err != nil {
+		return err
+	}
+	_, err := a.file.WriteString(line)
+	return err
+}
+
+/*
+  Load replays the Appender's file into a fresh StRingBuffer via Unslice,
+  keeping at most the last capacity lines. An empty file gives an empty
+  StRingBuffer of the Appender's capacity.
+*/
+func (a *Appender) Load() (*StRingBuffer, error) {
+	if _, err := a.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(a.file)
+	var lines []string
+	for {
+		n, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		lines = append(lines, string(b))
+	}
+	if _, err := a.file.Seek(0, io.SeekEnd); err != nil {
+		return nil, err
+	}
+	if len(lines) > a.capacity {
+		lines = lines[len(lines)-a.capacity:]
+	}
+	if len(lines) == 0 {
+		return NewStRingBuffer(a.capacity), nil
+	}
+	return Unslice(lines), nil
+}
+
+// rotate truncates the file down to its last capacity lines.
+func (a *Appender) rotate() error {
+	tail, err := a.Load()
+	if err != nil {
+		return err
+	}
+	if err := a.file.Truncate(0); err != nil {
+		return err
+	}
+	for _, line := range tail.Slice() {
+		if err := a.writeEntry(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
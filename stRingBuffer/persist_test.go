@@ -0,0 +1,82 @@
+package stRingBuffer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMarshalUnmarshalBinary(t *testing.T) {
+	srb := NewStRingBuffer(3)
+	srb.Push("1", "2", "3", "4")
+
+	data, err := srb.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned an unexpected error '%s'.", err)
+	}
+
+	got := NewStRingBuffer(0)
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary returned an unexpected error '%s'.", err)
+	}
+	if strings.Join(got.Slice(), ",") != strings.Join(srb.Slice(), ",") {
+		t.Errorf("UnmarshalBinary didn't restore the original contents, got '%v', want '%v'.", got.Slice(), srb.Slice())
+	}
+	if got.Capacity() != srb.Capacity() {
+		t.Errorf("UnmarshalBinary didn't restore the original Capacity(), got %d, want %d.", got.Capacity(), srb.Capacity())
+	}
+}
+
+func TestSaveLoadFile(t *testing.T) {
+	srb := NewStRingBuffer(3)
+	srb.Push("1", "2", "3")
+	path := filepath.Join(t.TempDir(), "srb.bin")
+
+	if err := srb.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile returned an unexpected error '%s'.", err)
+	}
+	got, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile returned an unexpected error '%s'.", err)
+	}
+	if strings.Join(got.Slice(), ",") != "1,2,3" {
+		t.Errorf("LoadFile didn't restore the original contents, got '%v'.", got.Slice())
+	}
+}
+
+func TestAppenderRoundtripAndRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "appender.log")
+	a, err := NewAppender(path, 3, 1)
+	if err != nil {
+		t.Fatalf("NewAppender returned an unexpected error '%s'.", err)
+	}
+	defer a.Close()
+
+	for _, line := range []string{"1", "2", "3", "4", "5"} {
+		if err := a.Append(line); err != nil {
+			t.Fatalf("Append returned an unexpected error '%s'.", err)
+		}
+	}
+
+	srb, err := a.Load()
+	if err != nil {
+		t.Fatalf("Load returned an unexpected error '%s'.", err)
+	}
+	if strings.Join(srb.Slice(), ",") != "3,4,5" {
+		t.Errorf("Load didn't replay the last capacity lines, got '%v'.", srb.Slice())
+	}
+
+	//Reopening the file after a crash should give the same, rotated history:
+	reopened, err := NewAppender(path, 3, 1)
+	if err != nil {
+		t.Fatalf("NewAppender returned an unexpected error '%s'.", err)
+	}
+	defer reopened.Close()
+	recovered, err := reopened.Load()
+	if err != nil {
+		t.Fatalf("Load returned an unexpected error '%s'.", err)
+	}
+	if strings.Join(recovered.Slice(), ",") != "3,4,5" {
+		t.Errorf("Load after reopening gave '%v', expected the rotated history.", recovered.Slice())
+	}
+}
@@ -5,7 +5,12 @@
 */
 package stRingBuffer
 
-import "fmt"
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
 
 type StRingBuffer struct {
 	start int
@@ -13,6 +18,10 @@ type StRingBuffer struct {
 	lines []string
 	// Mainly so we can distinguish empty and full:
 	length int
+	// Holds a trailing, not yet newline-terminated chunk written via Write:
+	pending string
+	// Byte offset into the joined contents for the next Read:
+	readCursor int
 }
 
 // Creates an empty StRingBuffer with a given size.
@@ -69,6 +78,28 @@ func (s *StRingBuffer) Push(lines ...string) *StRingBuffer {
 	return s
 }
 
+/*
+  Write implements io.Writer.
+  Incoming bytes are split on "\n", and each completed line is passed to Push,
+  so overflow overwrites the oldest entries just like any other Push.
+  A trailing, not yet newline-terminated chunk is buffered internally and
+  prepended to the next Write call.
+*/
+func (s *StRingBuffer) Write(p []byte) (int, error) {
+	data := s.pending + string(p)
+	parts := strings.Split(data, "\n")
+	s.pending = parts[len(parts)-1]
+	for _, line := range parts[:len(parts)-1] {
+		s.Push(line)
+	}
+	return len(p), nil
+}
+
+// WriteString implements io.StringWriter on top of Write.
+func (s *StRingBuffer) WriteString(str string) (int, error) {
+	return s.Write([]byte(str))
+}
+
 /*
   Return the last string in a StRingBuffer.
   The string will be removed from the StRingBuffer.
@@ -156,6 +187,74 @@ func (s *StRingBuffer) EachR(f func(string)) *StRingBuffer {
 	return s.MapR(mkId(f))
 }
 
+/*
+  Returns the string at logical index i, where 0 is the element Shift
+  would return next and Length()-1 is the element Pop would return next.
+  Returns "" if i is out of range.
+*/
+func (s *StRingBuffer) At(i int) string {
+	if i < 0 || i >= s.Length() {
+		return ""
+	}
+	return s.lines[s.mod(s.start+i)]
+}
+
+/*
+  Sets the string at logical index i, using the same indexing as At.
+  Out-of-range indices are silently ignored.
+*/
+func (s *StRingBuffer) Set(i int, str string) {
+	if i < 0 || i >= s.Length() {
+		return
+	}
+	s.lines[s.mod(s.start+i)] = str
+}
+
+/*
+  Returns a new StRingBuffer of the same Capacity(), holding only the
+  strings for which f returns true, in their original order.
+*/
+func (s *StRingBuffer) Filter(f func(string) bool) *StRingBuffer {
+	filtered := NewStRingBuffer(s.Capacity())
+	for i := 0; i < s.Length(); i++ {
+		if line := s.At(i); f(line) {
+			filtered.Push(line)
+		}
+	}
+	return filtered
+}
+
+// Folds f over a StRingBuffer's strings from start to finish, starting from init.
+func (s *StRingBuffer) Fold(init string, f func(acc, str string) string) string {
+	acc := init
+	for i := 0; i < s.Length(); i++ {
+		acc = f(acc, s.At(i))
+	}
+	return acc
+}
+
+// Like Fold, but in reverse order.
+func (s *StRingBuffer) FoldR(init string, f func(acc, str string) string) string {
+	acc := init
+	for i := s.Length() - 1; i >= 0; i-- {
+		acc = f(acc, s.At(i))
+	}
+	return acc
+}
+
+/*
+  Returns the logical index (as used by At/Set, 0 == Shift-end) of the
+  first string for which f returns true, or -1 if none matches.
+*/
+func (s *StRingBuffer) FindIndex(f func(string) bool) int {
+	for i := 0; i < s.Length(); i++ {
+		if f(s.At(i)) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Returns a slice representing the contents of a StRingBuffer.
 func (s *StRingBuffer) Slice() []string {
 	slice := make([]string, s.Length())
@@ -173,6 +272,34 @@ func (s *StRingBuffer) Slice() []string {
 	return slice
 }
 
+/*
+  Read implements io.Reader.
+  It emits the stored lines from start to end, joined by "\n", without
+  draining them via Pop/Shift. An internal read cursor is kept so that
+  short reads are honored across calls: each call picks up where the
+  previous one left off, and io.EOF is returned once the joined contents
+  are exhausted.
+*/
+func (s *StRingBuffer) Read(p []byte) (int, error) {
+	content := strings.Join(s.Slice(), "\n")
+	if s.readCursor >= len(content) {
+		s.readCursor = 0
+		return 0, io.EOF
+	}
+	n := copy(p, content[s.readCursor:])
+	s.readCursor += n
+	return n, nil
+}
+
+/*
+  Scanner returns a *bufio.Scanner that reads the stored lines from start
+  to end, one per Scan(), without draining the StRingBuffer and
+  independently of Read's read cursor.
+*/
+func (s *StRingBuffer) Scanner() *bufio.Scanner {
+	return bufio.NewScanner(strings.NewReader(strings.Join(s.Slice(), "\n")))
+}
+
 /*
   Returns a StRingBuffer representing a given []string.
   The StRingBuffer will be full.
@@ -2,7 +2,9 @@ package stRingBuffer
 
 import (
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -88,3 +90,92 @@ func TestMapEach(t *testing.T) {
 		t.Errorf("StRingBuffer MapEach test didn't hold the expected result but '%s'.\n", sum)
 	}
 }
+
+func TestFilterFoldFind(t *testing.T) {
+	srb := NewStRingBuffer(5)
+	srb.Push("1", "2", "3", "4", "5")
+
+	//Testing At/Set:
+	if got := srb.At(0); got != "1" {
+		t.Errorf("StRingBuffer At(0) gave '%s', expected '1'.", got)
+	}
+	if got := srb.At(4); got != "5" {
+		t.Errorf("StRingBuffer At(4) gave '%s', expected '5'.", got)
+	}
+	if got := srb.At(5); got != "" {
+		t.Errorf("StRingBuffer At(5) gave '%s', expected ''.", got)
+	}
+	srb.Set(2, "30")
+	if got := srb.At(2); got != "30" {
+		t.Errorf("StRingBuffer Set(2, \"30\") didn't take effect, At(2) gave '%s'.", got)
+	}
+
+	//Testing Filter:
+	isEven := func(s string) bool {
+		n, _ := strconv.Atoi(s)
+		return n%2 == 0
+	}
+	filtered := srb.Filter(isEven)
+	if s := strings.Join(filtered.Slice(), ","); s != "2,30,4" {
+		t.Errorf("StRingBuffer Filter didn't give the expected result, got '%s'.", s)
+	}
+	if filtered.Capacity() != srb.Capacity() {
+		t.Errorf("StRingBuffer Filter didn't preserve Capacity(), got %d.", filtered.Capacity())
+	}
+
+	//Testing Fold/FoldR, over lines "1", "2", "30", "4", "5":
+	concat := func(acc, s string) string { return acc + s }
+	if got := srb.Fold("", concat); got != "123045" {
+		t.Errorf("StRingBuffer Fold didn't give the expected result, got '%s'.", got)
+	}
+	if got := srb.FoldR("", concat); got != "543021" {
+		t.Errorf("StRingBuffer FoldR didn't give the expected result, got '%s'.", got)
+	}
+
+	//Testing FindIndex:
+	if got := srb.FindIndex(isEven); got != 1 {
+		t.Errorf("StRingBuffer FindIndex didn't find the expected logical index, got %d.", got)
+	}
+	if got := srb.FindIndex(func(s string) bool { return s == "x" }); got != -1 {
+		t.Errorf("StRingBuffer FindIndex should give -1 for no match, got %d.", got)
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	srb := NewStRingBuffer(3)
+	//Testing Write with a partial trailing line:
+	srb.Write([]byte("1\n2\n3\npart"))
+	if s := srb.Slice(); len(s) != 3 || strings.Join(s, ",") != "1,2,3" {
+		t.Errorf("StRingBuffer Write didn't Push completed lines as expected, got '%v'.", s)
+	}
+	//The partial line completes on the next Write, and overflow overwrites "1":
+	srb.WriteString("ial\n")
+	if s := srb.Slice(); strings.Join(s, ",") != "2,3,partial" {
+		t.Errorf("StRingBuffer Write didn't buffer the trailing partial line, got '%v'.", s)
+	}
+	//Testing Read across short reads:
+	buf := make([]byte, 4)
+	got := ""
+	for {
+		n, err := srb.Read(buf)
+		got += string(buf[:n])
+		if err == io.EOF {
+			break
+		}
+	}
+	if got != "2\n3\npartial" {
+		t.Errorf("StRingBuffer Read didn't emit the stored lines joined by '\\n', got '%s'.", got)
+	}
+	if srb.Empty() {
+		t.Errorf("StRingBuffer Read should not drain the buffer.")
+	}
+	//Testing Scanner:
+	scanner := srb.Scanner()
+	lines := []string{}
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if strings.Join(lines, ",") != "2,3,partial" {
+		t.Errorf("StRingBuffer Scanner didn't yield the stored lines, got '%v'.", lines)
+	}
+}
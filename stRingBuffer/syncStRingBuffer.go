@@ -0,0 +1,190 @@
+package stRingBuffer
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+)
+
+/*
+  SyncStRingBuffer wraps a StRingBuffer with a sync.Mutex and a sync.Cond,
+  so that it can be shared between a producer and a consumer goroutine.
+  Push keeps its overwrite-the-oldest, never-block log-tailing semantics;
+  PushStrict and ShiftBlocking block until there is room or data,
+  respectively, and Close unblocks every waiter: ShiftBlocking with
+  io.EOF once the buffer has drained, PushStrict with io.ErrClosedPipe.
+*/
+type SyncStRingBuffer struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	buf    *StRingBuffer
+	closed bool
+	// Holds state for the io.Writer/io.Reader facade below:
+	pending string
+	readBuf []byte
+}
+
+// Creates an empty SyncStRingBuffer with a given size.
+func NewSyncStRingBuffer(size int) *SyncStRingBuffer {
+	s := &SyncStRingBuffer{buf: NewStRingBuffer(size)}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+/*
+  Append a variable number of strings to a SyncStRingBuffer.
+  Like StRingBuffer.Push, this never blocks: if the buffer is full, the
+  oldest entries are overwritten. Waiting ShiftBlocking calls are woken up.
+*/
+func (s *SyncStRingBuffer) Push(lines ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return io.ErrClosedPipe
+	}
+	s.buf.Push(lines...)
+	s.cond.Broadcast()
+	return nil
+}
+
+/*
+  PushBlocking is Push under the given ctx: it reports ctx.Err() if ctx is
+  already done, but otherwise never blocks, since Push never blocks either.
+*/
+func (s *SyncStRingBuffer) PushBlocking(ctx context.Context, lines ...string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.Push(lines...)
+}
+
+/*
+  PushStrict appends lines one at a time, blocking while the SyncStRingBuffer
+  is full instead of overwriting the oldest entries. It returns early with
+  ctx.Err() if ctx is done, or io.ErrClosedPipe if Close is called while
+  waiting.
+*/
+func (s *SyncStRingBuffer) PushStrict(ctx context.Context, lines ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, line := range lines {
+		if err := s.waitLocked(ctx, func() bool { return s.buf.Full() && !s.closed }); err != nil {
+			return err
+		}
+		if s.closed {
+			return io.ErrClosedPipe
+		}
+		s.buf.Push(line)
+		s.cond.Broadcast()
+	}
+	return nil
+}
+
+/*
+  ShiftBlocking returns the first string in a SyncStRingBuffer, removing it,
+  blocking while the buffer is empty. It returns io.EOF once Close has been
+  called and the buffer has drained, or ctx.Err() if ctx is done first.
+*/
+func (s *SyncStRingBuffer) ShiftBlocking(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.waitLocked(ctx, func() bool { return s.buf.Empty() && !s.closed }); err != nil {
+		return "", err
+	}
+	if s.buf.Empty() {
+		return "", io.EOF
+	}
+	line := s.buf.Shift()
+	s.cond.Broadcast()
+	return line, nil
+}
+
+/*
+  Close marks a SyncStRingBuffer as closed, waking every blocked
+  PushStrict/ShiftBlocking call with io.ErrClosedPipe/io.EOF. Further
+  Push/PushStrict calls fail with io.ErrClosedPipe.
+*/
+func (s *SyncStRingBuffer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	s.cond.Broadcast()
+	return nil
+}
+
+/*
+  Write implements io.Writer. Like StRingBuffer.Write, incoming bytes are
+  split on "\n" and buffered until a line completes, but completed lines
+  are appended via PushStrict, so a full SyncStRingBuffer applies
+  backpressure to the writer instead of overwriting unread lines.
+*/
+func (s *SyncStRingBuffer) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	data := s.pending + string(p)
+	parts := strings.Split(data, "\n")
+	s.pending = parts[len(parts)-1]
+	full := parts[:len(parts)-1]
+	s.mu.Unlock()
+
+	for _, line := range full {
+		if err := s.PushStrict(context.Background(), line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+/*
+  Read implements io.Reader. It blocks until a line is available via
+  ShiftBlocking, then emits it followed by "\n", honoring short reads
+  across calls. It returns io.EOF once Close has been called and the
+  buffer has drained.
+*/
+func (s *SyncStRingBuffer) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	for len(s.readBuf) == 0 {
+		s.mu.Unlock()
+		line, err := s.ShiftBlocking(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		s.mu.Lock()
+		s.readBuf = []byte(line + "\n")
+	}
+	n := copy(p, s.readBuf)
+	s.readBuf = s.readBuf[n:]
+	s.mu.Unlock()
+	return n, nil
+}
+
+/*
+  waitLocked blocks on s.cond while cond() holds, returning early with
+  ctx.Err() if ctx is done. It must be called with s.mu held, and leaves
+  it held on return.
+*/
+func (s *SyncStRingBuffer) waitLocked(ctx context.Context, cond func() bool) error {
+	if !cond() {
+		return ctx.Err()
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.cond.Broadcast()
+			s.mu.Unlock()
+		case <-done:
+		}
+	}()
+
+	for cond() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.cond.Wait()
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,113 @@
+package stRingBuffer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSyncPushShiftBlocking(t *testing.T) {
+	srb := NewSyncStRingBuffer(2)
+	ctx := context.Background()
+
+	//ShiftBlocking on an empty buffer waits for a Push:
+	done := make(chan string, 1)
+	go func() {
+		line, err := srb.ShiftBlocking(ctx)
+		if err != nil {
+			t.Errorf("ShiftBlocking returned an unexpected error '%s'.", err)
+		}
+		done <- line
+	}()
+	time.Sleep(10 * time.Millisecond)
+	srb.Push("1")
+	select {
+	case line := <-done:
+		if line != "1" {
+			t.Errorf("ShiftBlocking gave '%s', expected '1'.", line)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("ShiftBlocking didn't wake up after a Push.")
+	}
+
+	//Push still overwrites the oldest entry when full:
+	srb.Push("1", "2", "3")
+	l1, _ := srb.ShiftBlocking(ctx)
+	l2, _ := srb.ShiftBlocking(ctx)
+	if l1 != "2" || l2 != "3" {
+		t.Errorf("Push didn't overwrite the oldest entry as expected, got '%s', '%s'.", l1, l2)
+	}
+}
+
+func TestSyncPushStrictBlocks(t *testing.T) {
+	srb := NewSyncStRingBuffer(1)
+	ctx := context.Background()
+	if err := srb.PushStrict(ctx, "1"); err != nil {
+		t.Fatalf("PushStrict on a non-full buffer returned an unexpected error '%s'.", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- srb.PushStrict(ctx, "2")
+	}()
+	select {
+	case <-done:
+		t.Errorf("PushStrict didn't block while the buffer was full.")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	srb.ShiftBlocking(ctx)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("PushStrict returned an unexpected error '%s'.", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("PushStrict didn't unblock after room was made.")
+	}
+}
+
+func TestSyncClose(t *testing.T) {
+	srb := NewSyncStRingBuffer(1)
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := srb.ShiftBlocking(ctx)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	srb.Close()
+	select {
+	case err := <-done:
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("ShiftBlocking on a closed, empty buffer should give io.EOF, got '%s'.", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("ShiftBlocking didn't wake up after Close.")
+	}
+
+	if err := srb.Push("1"); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("Push on a closed buffer should give io.ErrClosedPipe, got '%s'.", err)
+	}
+}
+
+func TestSyncReadWriteCloser(t *testing.T) {
+	srb := NewSyncStRingBuffer(8)
+	var _ io.ReadWriteCloser = srb
+
+	go func() {
+		io.WriteString(srb, "1\n2\n3\n")
+		srb.Close()
+	}()
+
+	got, err := io.ReadAll(srb)
+	if err != nil {
+		t.Errorf("ReadAll on a SyncStRingBuffer returned an unexpected error '%s'.", err)
+	}
+	if string(got) != "1\n2\n3\n" {
+		t.Errorf("SyncStRingBuffer Read gave '%s', expected '1\\n2\\n3\\n'.", got)
+	}
+}
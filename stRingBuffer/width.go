@@ -0,0 +1,156 @@
+package stRingBuffer
+
+// WidthFunc gives the display width of a single rune, in terminal columns.
+type WidthFunc func(rune) int
+
+/*
+  DefaultWidthFunc is used by DisplayWidth, PushWrapped and TailLines to
+  measure runes. It treats East-Asian wide runes as width 2, control
+  characters as width 0, and everything else as width 1. Assign a
+  different WidthFunc to change this, e.g. to delegate to a dedicated
+  East-Asian-width package.
+*/
+var DefaultWidthFunc WidthFunc = defaultRuneWidth
+
+// Ranges of runes that are commonly rendered at double width by terminals.
+var wideRuneRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x2E80, 0x303E},   // CJK Radicals, Kangxi Radicals, CJK Symbols and Punctuation
+	{0x3041, 0x33FF},   // Hiragana .. CJK Compatibility
+	{0x3400, 0x4DBF},   // CJK Unified Ideographs Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xA000, 0xA4CF},   // Yi Syllables and Radicals
+	{0xAC00, 0xD7A3},   // Hangul Syllables
+	{0xF900, 0xFAFF},   // CJK Compatibility Ideographs
+	{0xFE30, 0xFE4F},   // CJK Compatibility Forms
+	{0xFF00, 0xFF60},   // Fullwidth Forms
+	{0xFFE0, 0xFFE6},   // Fullwidth Signs
+	{0x20000, 0x2FFFD}, // CJK Unified Ideographs Extension B and beyond
+	{0x30000, 0x3FFFD},
+}
+
+func isWideRune(r rune) bool {
+	for _, rng := range wideRuneRanges {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultRuneWidth is DefaultWidthFunc's initial value.
+func defaultRuneWidth(r rune) int {
+	switch {
+	case r < 0x20 || r == 0x7f:
+		return 0
+	case isWideRune(r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+/*
+  skipSGR tells whether runes[i:] starts a CSI SGR escape sequence
+  ("ESC [ ... m"), returning the index just past it if so.
+*/
+func skipSGR(runes []rune, i int) (int, bool) {
+	if runes[i] != '\x1b' || i+1 >= len(runes) || runes[i+1] != '[' {
+		return i, false
+	}
+	j := i + 2
+	for j < len(runes) && runes[j] != 'm' {
+		j++
+	}
+	if j >= len(runes) {
+		return i, false
+	}
+	return j + 1, true
+}
+
+// displayWidth gives line's display width, skipping SGR escape sequences.
+func displayWidth(line string, wf WidthFunc) int {
+	width := 0
+	runes := []rune(line)
+	for i := 0; i < len(runes); {
+		if end, ok := skipSGR(runes, i); ok {
+			i = end
+			continue
+		}
+		width += wf(runes[i])
+		i++
+	}
+	return width
+}
+
+/*
+  wrapLine soft-wraps line into rows of at most cols display columns,
+  treating wide runes per DefaultWidthFunc and preserving SGR escape
+  sequences in the output without counting them towards cols.
+*/
+func wrapLine(cols int, line string) []string {
+	if cols <= 0 {
+		return []string{line}
+	}
+
+	var rows []string
+	var row []rune
+	width := 0
+	runes := []rune(line)
+	for i := 0; i < len(runes); {
+		if end, ok := skipSGR(runes, i); ok {
+			row = append(row, runes[i:end]...)
+			i = end
+			continue
+		}
+		w := DefaultWidthFunc(runes[i])
+		if width+w > cols && len(row) > 0 {
+			rows = append(rows, string(row))
+			row = nil
+			width = 0
+		}
+		row = append(row, runes[i])
+		width += w
+		i++
+	}
+	return append(rows, string(row))
+}
+
+// DisplayWidth sums the display width of every line currently stored.
+func (s *StRingBuffer) DisplayWidth() int {
+	total := 0
+	for i := 0; i < s.Length(); i++ {
+		total += displayWidth(s.At(i), DefaultWidthFunc)
+	}
+	return total
+}
+
+/*
+  PushWrapped soft-wraps line into rows of at most cols display columns
+  and Pushes each row as its own entry, so a long input occupies several
+  stored lines instead of one. The original StRingBuffer is returned for
+  chaining.
+*/
+func (s *StRingBuffer) PushWrapped(cols int, line string) *StRingBuffer {
+	return s.Push(wrapLine(cols, line)...)
+}
+
+/*
+  TailLines returns the last maxRows display rows across the stored
+  lines, wrapping each one to cols display columns as PushWrapped would,
+  without draining or otherwise modifying the StRingBuffer.
+*/
+func (s *StRingBuffer) TailLines(maxRows, cols int) []string {
+	if maxRows <= 0 {
+		return nil
+	}
+
+	var rows []string
+	for i := 0; i < s.Length(); i++ {
+		rows = append(rows, wrapLine(cols, s.At(i))...)
+	}
+	if len(rows) > maxRows {
+		rows = rows[len(rows)-maxRows:]
+	}
+	return rows
+}
@@ -0,0 +1,50 @@
+package stRingBuffer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	srb := NewStRingBuffer(3)
+	srb.Push("ab", "\x1b[31mcd\x1b[0m", "中文") // 2 + 2 + 4 columns
+	if got := srb.DisplayWidth(); got != 8 {
+		t.Errorf("StRingBuffer DisplayWidth gave %d, expected 8.", got)
+	}
+}
+
+func TestPushWrapped(t *testing.T) {
+	srb := NewStRingBuffer(10)
+	srb.PushWrapped(3, "abcdefg")
+	if got := strings.Join(srb.Slice(), "|"); got != "abc|def|g" {
+		t.Errorf("StRingBuffer PushWrapped didn't soft-wrap ASCII as expected, got '%s'.", got)
+	}
+
+	srb2 := NewStRingBuffer(10)
+	//Wide runes count as width 2, so only one fits per 3-column row alongside "a":
+	srb2.PushWrapped(3, "a中b中")
+	if got := strings.Join(srb2.Slice(), "|"); got != "a中|b中" {
+		t.Errorf("StRingBuffer PushWrapped didn't account for wide runes, got '%s'.", got)
+	}
+
+	srb3 := NewStRingBuffer(10)
+	//SGR escapes must not count towards cols, but must be preserved:
+	srb3.PushWrapped(2, "\x1b[31mab\x1b[0mcd")
+	if got := strings.Join(srb3.Slice(), "|"); got != "\x1b[31mab\x1b[0m|cd" {
+		t.Errorf("StRingBuffer PushWrapped didn't preserve SGR escapes, got '%q'.", got)
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	srb := NewStRingBuffer(10)
+	srb.Push("abcdef", "ghi")
+	got := srb.TailLines(2, 3)
+	want := []string{"def", "ghi"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("StRingBuffer TailLines gave '%v', expected '%v'.", got, want)
+	}
+	//TailLines must not drain the StRingBuffer:
+	if srb.Empty() {
+		t.Errorf("StRingBuffer TailLines should not drain the buffer.")
+	}
+}